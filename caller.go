@@ -0,0 +1,30 @@
+package mute
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+)
+
+// muteDir is the directory containing this file, used by caller to skip
+// past frames inside the mute package itself.
+var muteDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// caller walks up the call stack from its own caller and returns the
+// file:line of the first frame outside the mute package, or "" if none is
+// found.
+func caller() string {
+	for skip := 2; ; skip++ {
+		_, file, line, ok := runtime.Caller(skip)
+		if !ok {
+			return ""
+		}
+
+		if filepath.Dir(file) != muteDir {
+			return fmt.Sprintf("%s:%d", file, line)
+		}
+	}
+}
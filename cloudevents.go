@@ -0,0 +1,60 @@
+package mute
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CloudEventsFormatter wraps an Event in a CloudEvents 1.0 JSON envelope, so
+// a Route can point at any CloudEvents-compatible sink.
+type CloudEventsFormatter struct {
+	// Source identifies the context that produced the event, used as the
+	// CloudEvents "source" attribute.
+	Source string
+}
+
+// cloudEvent is the CloudEvents 1.0 JSON envelope.
+type cloudEvent struct {
+	SpecVersion string         `json:"specversion"`
+	ID          string         `json:"id"`
+	Source      string         `json:"source"`
+	Type        string         `json:"type"`
+	Time        time.Time      `json:"time"`
+	Data        cloudEventData `json:"data"`
+}
+
+// cloudEventData is the payload carried under the CloudEvents "data"
+// attribute.
+type cloudEventData struct {
+	Message string            `json:"message"`
+	Data    map[string]string `json:"data,omitempty"`
+}
+
+// Format wraps e in a CloudEvents 1.0 JSON envelope. Type is taken from
+// e.Type if set, otherwise derived from e.Level.
+func (f CloudEventsFormatter) Format(e Event) ([]byte, error) {
+	eventType := e.Type
+	if eventType == "" {
+		eventType = "dev.mute.event." + e.Level.String()
+	}
+
+	result, err := json.Marshal(cloudEvent{
+		SpecVersion: "1.0",
+		ID:          newUUID(),
+		Source:      f.Source,
+		Type:        eventType,
+		Time:        e.Time,
+		Data: cloudEventData{
+			Message: e.Message,
+			Data:    e.Data,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Failed to convert an event to a CloudEvent: \"%v\"", e.Message,
+		)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,47 @@
+package mute
+
+import "time"
+
+// Event describes an event and can be sent by a Logger.
+type Event struct {
+	// Message is the short text presented by an event.
+	Message string
+	// Data is a map of key-value pairs that can add context to an event.
+	Data map[string]string
+	// Level is the severity of the event. The zero value is LevelInfo.
+	Level Level
+	// Time is when the event occurred. If left zero, Send sets it to
+	// time.Now() before delivering the event to any route.
+	Time time.Time
+	// Caller is the file:line of the code that produced the event. If left
+	// empty, Send captures it automatically; a route only renders it when
+	// its ShowCaller is set.
+	Caller string `json:"Caller,omitempty"`
+	// Type is an explicit event type, used by CloudEventsFormatter as the
+	// CloudEvents "type" attribute. If left empty, it is derived from
+	// Level.
+	Type string `json:"Type,omitempty"`
+	// Topic tags the event for routing to Route.Topics and logger.Subscribe
+	// handlers. The empty topic still matches a Route with no Topics set.
+	Topic string `json:"Topic,omitempty"`
+}
+
+// Debug returns a new Event at LevelDebug.
+func Debug(message string, data map[string]string) Event {
+	return Event{Message: message, Data: data, Level: LevelDebug}
+}
+
+// Info returns a new Event at LevelInfo.
+func Info(message string, data map[string]string) Event {
+	return Event{Message: message, Data: data, Level: LevelInfo}
+}
+
+// Warn returns a new Event at LevelWarn.
+func Warn(message string, data map[string]string) Event {
+	return Event{Message: message, Data: data, Level: LevelWarn}
+}
+
+// Error returns a new Event at LevelError.
+func Error(message string, data map[string]string) Event {
+	return Event{Message: message, Data: data, Level: LevelError}
+}
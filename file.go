@@ -0,0 +1,147 @@
+package mute
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// fileQueueSize is the capacity of the buffered channel used to queue events
+// destined for a file sink, allowing Send to return without waiting on disk
+// I/O.
+const fileQueueSize = 256
+
+// fileSink owns the file handle, rotation state, and delivery goroutine for
+// a Route configured with File.
+type fileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	events chan Event
+	wg     sync.WaitGroup
+
+	file *os.File
+	size int64
+}
+
+// newFileSink returns a fileSink for the given path, ready to be started.
+func newFileSink(path string, maxSize int64, maxBackups int) *fileSink {
+	return &fileSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+		events:     make(chan Event, fileQueueSize),
+	}
+}
+
+// start launches the goroutine that writes queued events to disk using the
+// given formatter, until close is called.
+func (s *fileSink) start(f Formatter) {
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		for e := range s.events {
+			if err := s.write(e, f); err != nil {
+				fmt.Fprintf(os.Stderr, "mute: failed to write event to %q: %v\n", s.path, err)
+			}
+		}
+
+		if s.file != nil {
+			s.file.Close()
+		}
+	}()
+}
+
+// enqueue queues an event for delivery, blocking only if the queue is full.
+func (s *fileSink) enqueue(e Event) {
+	s.events <- e
+}
+
+// close drains the queue and waits for the delivery goroutine to exit,
+// closing the underlying file handle.
+func (s *fileSink) close() {
+	close(s.events)
+	s.wg.Wait()
+}
+
+// write formats e and appends it, followed by a newline, to the sink's file,
+// rotating first if the write would exceed maxSize.
+func (s *fileSink) write(e Event, f Formatter) error {
+	message, err := f.Format(e)
+	if err != nil {
+		return err
+	}
+
+	line := append(message, '\n')
+
+	if s.file == nil {
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+
+	if s.maxSize > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write to log file %q: %w", s.path, err)
+	}
+
+	return nil
+}
+
+// open opens the sink's file for appending, creating it if necessary, and
+// records its current size.
+func (s *fileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file %q: %w", s.path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat log file %q: %w", s.path, err)
+	}
+
+	s.file = file
+	s.size = info.Size()
+
+	return nil
+}
+
+// rotate closes the current file, shifts existing backups up by one
+// (foo.log.1 -> foo.log.2, and so on, dropping anything past maxBackups),
+// renames the active file to foo.log.1, and reopens it.
+func (s *fileSink) rotate() error {
+	if s.file != nil {
+		s.file.Close()
+		s.file = nil
+	}
+
+	if s.maxBackups > 0 {
+		oldest := fmt.Sprintf("%s.%d", s.path, s.maxBackups)
+		os.Remove(oldest)
+
+		for i := s.maxBackups - 1; i >= 1; i-- {
+			from := fmt.Sprintf("%s.%d", s.path, i)
+			to := fmt.Sprintf("%s.%d", s.path, i+1)
+
+			if _, err := os.Stat(from); err == nil {
+				os.Rename(from, to)
+			}
+		}
+
+		os.Rename(s.path, s.path+".1")
+	}
+
+	return s.open()
+}
@@ -0,0 +1,6 @@
+package mute
+
+// Formatter converts an Event to the bytes a Route will store or deliver.
+type Formatter interface {
+	Format(Event) ([]byte, error)
+}
@@ -0,0 +1,60 @@
+package mute
+
+import (
+	"fmt"
+	"os"
+)
+
+// Handler reacts to events published on a topic it has subscribed to via
+// logger.Subscribe.
+type Handler interface {
+	Handle(Event) error
+}
+
+// Subscription pairs a Handler with the topic it should receive events for,
+// for registration at construction time via Init. Use logger.Subscribe
+// instead to register a Handler afterward.
+type Subscription struct {
+	Topic   string
+	Handler Handler
+}
+
+// publish dispatches e to every handler subscribed to e.Topic, each on its
+// own goroutine. A handler that panics or returns an error is logged to
+// stderr rather than affecting Send or any other handler.
+func (l *logger) publish(e Event) {
+	l.subscribersMu.RLock()
+	handlers := l.subscribers[e.Topic]
+	l.subscribersMu.RUnlock()
+
+	for _, h := range handlers {
+		l.handlersWg.Add(1)
+
+		go func(h Handler) {
+			defer l.handlersWg.Done()
+
+			defer func() {
+				if r := recover(); r != nil {
+					fmt.Fprintf(os.Stderr, "mute: handler panicked on topic %q: %v\n", e.Topic, r)
+				}
+			}()
+
+			if err := h.Handle(e); err != nil {
+				fmt.Fprintf(os.Stderr, "mute: handler returned an error on topic %q: %v\n", e.Topic, err)
+			}
+		}(h)
+	}
+}
+
+// Subscribe registers h to receive every event published with the given
+// topic.
+func (l *logger) Subscribe(topic string, h Handler) {
+	l.subscribersMu.Lock()
+	defer l.subscribersMu.Unlock()
+
+	if l.subscribers == nil {
+		l.subscribers = make(map[string][]Handler)
+	}
+
+	l.subscribers[topic] = append(l.subscribers[topic], h)
+}
@@ -0,0 +1,24 @@
+package mute
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JSON formats events as JSON.
+var JSON Formatter = JSONFormatter{}
+
+// JSONFormatter formats an Event as JSON.
+type JSONFormatter struct{}
+
+// Format marshals e to JSON.
+func (JSONFormatter) Format(e Event) ([]byte, error) {
+	result, err := json.Marshal(e)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Failed to convert an event to JSON: \"%v\"", e.Message,
+		)
+	}
+
+	return result, nil
+}
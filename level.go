@@ -0,0 +1,48 @@
+package mute
+
+import "encoding/json"
+
+// Level describes the severity of an Event.
+type Level int
+
+const (
+	// LevelDebug is the lowest severity, useful for diagnostic information
+	// that is normally too noisy to keep around.
+	LevelDebug Level = iota - 1
+	// LevelInfo is the default severity for an Event constructed without an
+	// explicit Level.
+	LevelInfo
+	// LevelWarn indicates something unexpected that does not require
+	// immediate attention.
+	LevelWarn
+	// LevelError indicates a failure that likely requires attention.
+	LevelError
+	// LevelFatal is the highest severity, reserved for failures that a
+	// program cannot recover from.
+	LevelFatal
+)
+
+// String returns the lowercase name of the level, as used by JSONFormatter
+// and TextFormatter.
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	case LevelFatal:
+		return "fatal"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON renders the level as its string name rather than the
+// underlying integer.
+func (l Level) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
@@ -0,0 +1,200 @@
+package mute
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// networkQueueSize is the capacity of the buffered channel used to
+	// queue events destined for a network sink. Once full, enqueue drops
+	// the oldest queued event to make room for the new one.
+	networkQueueSize = 256
+	// networkMinBackoff is the delay before the first reconnect attempt.
+	networkMinBackoff = 250 * time.Millisecond
+	// networkMaxBackoff caps the delay between reconnect attempts.
+	networkMaxBackoff = 30 * time.Second
+)
+
+// Stats reports delivery statistics for a Route.
+type Stats struct {
+	// Dropped is the number of events discarded because a route's network
+	// queue was full.
+	Dropped uint64
+}
+
+// networkSink owns the connection, reconnect state, and delivery goroutine
+// for a Route configured with Network.
+type networkSink struct {
+	network   string
+	address   string
+	tlsConfig *tls.Config
+
+	events chan Event
+	wg     sync.WaitGroup
+
+	conn        net.Conn
+	backoff     time.Duration
+	nextAttempt time.Time
+	dropped     uint64
+}
+
+// newNetworkSink parses raw (e.g. "tcp://host:514", "unix:///var/run/log.sock")
+// and returns a networkSink ready to be started.
+func newNetworkSink(raw string, tlsConfig *tls.Config) (*networkSink, error) {
+	network, address, err := parseNetworkAddr(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkSink{
+		network:   network,
+		address:   address,
+		tlsConfig: tlsConfig,
+		events:    make(chan Event, networkQueueSize),
+	}, nil
+}
+
+// parseNetworkAddr splits a Route.Network URL into the network and address
+// arguments expected by net.Dial.
+func parseNetworkAddr(raw string) (network, address string, err error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid Route.Network %q: %w", raw, err)
+	}
+
+	switch u.Scheme {
+	case "tcp", "udp":
+		return u.Scheme, u.Host, nil
+	case "unix":
+		return u.Scheme, u.Path, nil
+	default:
+		return "", "", fmt.Errorf("unsupported Route.Network scheme %q", u.Scheme)
+	}
+}
+
+// start launches the goroutine that writes queued events to the network
+// using the given formatter, until close is called.
+func (s *networkSink) start(f Formatter) {
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		for e := range s.events {
+			if err := s.write(e, f); err != nil {
+				fmt.Fprintf(os.Stderr, "mute: failed to write event to %s://%s: %v\n", s.network, s.address, err)
+			}
+		}
+
+		if s.conn != nil {
+			s.conn.Close()
+		}
+	}()
+}
+
+// enqueue queues an event for delivery, dropping the oldest queued event if
+// the queue is full.
+func (s *networkSink) enqueue(e Event) {
+	for {
+		select {
+		case s.events <- e:
+			return
+		default:
+			select {
+			case <-s.events:
+				atomic.AddUint64(&s.dropped, 1)
+			default:
+			}
+		}
+	}
+}
+
+// close drains the queue and waits for the delivery goroutine to exit,
+// closing the underlying connection.
+func (s *networkSink) close() {
+	close(s.events)
+	s.wg.Wait()
+}
+
+// write formats e and sends it over the sink's connection, reconnecting
+// first if necessary. Datagram connections (udp) send one packet per event.
+// Stream connections frame each message so a reader can find its boundary:
+// SyslogFormatter output uses RFC 6587 octet-counting ("MSGLEN SP MSG"),
+// while every other formatter is newline-delimited.
+func (s *networkSink) write(e Event, f Formatter) error {
+	message, err := f.Format(e)
+	if err != nil {
+		return err
+	}
+
+	switch _, syslog := f.(SyslogFormatter); {
+	case s.network == "udp":
+		// no framing, one packet per event
+	case syslog:
+		message = append([]byte(fmt.Sprintf("%d ", len(message))), message...)
+	default:
+		message = append(message, '\n')
+	}
+
+	conn, err := s.connect()
+	if err != nil {
+		return err
+	}
+
+	if _, err := conn.Write(message); err != nil {
+		s.conn = nil
+		return fmt.Errorf("failed to write to %s://%s: %w", s.network, s.address, err)
+	}
+
+	return nil
+}
+
+// connect returns the sink's connection, dialing it if it is not already
+// open. Failed dials back off exponentially: connect returns immediately
+// without redialing until the backoff elapses, so a single slow or down
+// sink cannot stall delivery to the rest of the queue.
+func (s *networkSink) connect() (net.Conn, error) {
+	if s.conn != nil {
+		return s.conn, nil
+	}
+
+	if now := time.Now(); now.Before(s.nextAttempt) {
+		return nil, fmt.Errorf("waiting to reconnect to %s://%s", s.network, s.address)
+	}
+
+	conn, err := s.dial()
+	if err != nil {
+		if s.backoff == 0 {
+			s.backoff = networkMinBackoff
+		} else if s.backoff *= 2; s.backoff > networkMaxBackoff {
+			s.backoff = networkMaxBackoff
+		}
+
+		s.nextAttempt = time.Now().Add(s.backoff)
+
+		return nil, fmt.Errorf("failed to connect to %s://%s: %w", s.network, s.address, err)
+	}
+
+	s.backoff = 0
+	s.conn = conn
+
+	return conn, nil
+}
+
+// dial opens a new connection, using TLS when the sink was configured with
+// a TLS config. TLS is never used for a unix socket, matching
+// Route.TLSConfig's documented behavior.
+func (s *networkSink) dial() (net.Conn, error) {
+	if s.tlsConfig != nil && s.network != "unix" {
+		return tls.Dial(s.network, s.address, s.tlsConfig)
+	}
+
+	return net.Dial(s.network, s.address)
+}
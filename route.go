@@ -0,0 +1,151 @@
+package mute
+
+import (
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Route describes a strategy that a logger will use to deliver an event.
+type Route struct {
+	Memory *[]string
+	// File, when set, is the path of a file that receives a formatted line
+	// for every delivered event. Delivery runs on a dedicated goroutine so
+	// logger.Send does not wait on disk I/O, unless the queue of pending
+	// events is full, in which case it blocks until there is room.
+	File string
+	// MaxFileSize is the size in bytes at which File is rotated. Zero
+	// disables size-based rotation.
+	MaxFileSize int64
+	// MaxBackups is the number of rotated files to retain, named
+	// File.1, File.2, and so on. Zero disables rotation entirely, even if
+	// MaxFileSize is set.
+	MaxBackups int
+	// Network, when set, is the address of a socket that receives a
+	// formatted event for every delivered event, e.g. "tcp://host:514",
+	// "udp://host:514", or "unix:///var/run/log.sock". Over udp each event is
+	// one packet; over a stream, SyslogFormatter output is octet-counted and
+	// any other formatter's output is newline-delimited.
+	// Delivery runs on a dedicated goroutine so logger.Send never blocks on
+	// the network, and drops the oldest queued event on overflow rather
+	// than block indefinitely; see Route.Stats.
+	Network string
+	// TLSConfig, when set, is used to dial Network over TLS. It has no
+	// effect on unix sockets.
+	TLSConfig *tls.Config
+	// Formatter converts each event to the bytes this route stores or
+	// delivers, e.g. mute.JSON, mute.Text, a SyslogFormatter, or a
+	// CloudEventsFormatter.
+	Formatter Formatter
+	// MinLevel is the lowest Level this route will deliver; events below it
+	// are silently dropped. The zero value, LevelInfo, admits everything
+	// but LevelDebug.
+	MinLevel Level
+	// ShowCaller controls whether the event's Caller field is rendered.
+	// Caller is always captured by Send; this only affects whether this
+	// route includes it in the delivered output.
+	ShowCaller bool
+	// Topics restricts delivery to events whose Topic is in this list. An
+	// empty Topics admits events with any topic, including none.
+	Topics []string
+
+	fileOnce sync.Once
+	fileSink *fileSink
+
+	networkOnce sync.Once
+	networkSink *networkSink
+	networkErr  error
+}
+
+// deliver will handle the conversion and storage or delivery of an event.
+func (r *Route) deliver(e Event) error {
+	if e.Level < r.MinLevel {
+		return nil
+	}
+
+	if len(r.Topics) > 0 && !containsTopic(r.Topics, e.Topic) {
+		return nil
+	}
+
+	if !r.ShowCaller {
+		e.Caller = ""
+	}
+
+	if r.Formatter == nil {
+		return fmt.Errorf("route has no Formatter configured")
+	}
+
+	if r.Memory != nil {
+		message, err := r.Formatter.Format(e)
+		if err != nil {
+			return err
+		}
+
+		*r.Memory = append(*r.Memory, string(message))
+	}
+
+	if r.File != "" {
+		r.fileOnce.Do(func() {
+			r.fileSink = newFileSink(r.File, r.MaxFileSize, r.MaxBackups)
+			r.fileSink.start(r.Formatter)
+		})
+
+		r.fileSink.enqueue(e)
+	}
+
+	if r.Network != "" {
+		r.networkOnce.Do(func() {
+			sink, err := newNetworkSink(r.Network, r.TLSConfig)
+			if err != nil {
+				r.networkErr = err
+				return
+			}
+
+			r.networkSink = sink
+			r.networkSink.start(r.Formatter)
+		})
+
+		if r.networkErr != nil {
+			return r.networkErr
+		}
+
+		r.networkSink.enqueue(e)
+	}
+
+	return nil
+}
+
+// close releases any resources held by the route, such as an open file
+// handle or network connection, blocking until its queued events have been
+// delivered.
+func (r *Route) close() {
+	if r.fileSink != nil {
+		r.fileSink.close()
+	}
+
+	if r.networkSink != nil {
+		r.networkSink.close()
+	}
+}
+
+// Stats reports delivery statistics for this route, such as the number of
+// events dropped from a full Network queue.
+func (r *Route) Stats() Stats {
+	if r.networkSink == nil {
+		return Stats{}
+	}
+
+	return Stats{Dropped: atomic.LoadUint64(&r.networkSink.dropped)}
+}
+
+// containsTopic reports whether topic appears in topics.
+func containsTopic(topics []string, topic string) bool {
+	for _, t := range topics {
+		if t == topic {
+			return true
+		}
+	}
+
+	return false
+}
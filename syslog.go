@@ -0,0 +1,69 @@
+package mute
+
+import (
+	"fmt"
+	"time"
+)
+
+// SyslogFormatter formats an Event as an RFC 5424 syslog message.
+type SyslogFormatter struct {
+	// Facility is the syslog facility code (RFC 5424 section 6.2.1). The
+	// zero value is facility 0, "kernel messages"; set it explicitly for
+	// anything else, e.g. 1 for user-level messages.
+	Facility int
+	// AppName identifies the application, used as the APP-NAME field. If
+	// left empty, "-" is sent.
+	AppName string
+	// Hostname identifies the device that originated the message. If left
+	// empty, "-" is sent.
+	Hostname string
+}
+
+// Format renders e as an RFC 5424 syslog message. Severity is derived from
+// e.Level.
+func (f SyslogFormatter) Format(e Event) ([]byte, error) {
+	hostname := f.Hostname
+	if hostname == "" {
+		hostname = "-"
+	}
+
+	appName := f.AppName
+	if appName == "" {
+		appName = "-"
+	}
+
+	timestamp := e.Time
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	message := e.Message
+	for k, v := range e.Data {
+		message += fmt.Sprintf(" [%v: %v]", k, v)
+	}
+
+	result := fmt.Sprintf(
+		"<%d>1 %s %s %s - - - %s",
+		f.Facility*8+syslogSeverity(e.Level), timestamp.Format(time.RFC3339), hostname, appName, message,
+	)
+
+	return []byte(result), nil
+}
+
+// syslogSeverity maps a Level to its RFC 5424 severity code.
+func syslogSeverity(l Level) int {
+	switch l {
+	case LevelDebug:
+		return 7
+	case LevelInfo:
+		return 6
+	case LevelWarn:
+		return 4
+	case LevelError:
+		return 3
+	case LevelFatal:
+		return 2
+	default:
+		return 6
+	}
+}
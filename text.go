@@ -0,0 +1,33 @@
+package mute
+
+import (
+	"fmt"
+	"time"
+)
+
+// Text formats events as a human-readable string.
+var Text Formatter = TextFormatter{}
+
+// TextFormatter formats an Event as a human-readable string.
+type TextFormatter struct{}
+
+// Format renders e as a formatted string.
+func (TextFormatter) Format(e Event) ([]byte, error) {
+	result := fmt.Sprintf("[%s] %s", e.Level, e.Message)
+
+	if !e.Time.IsZero() {
+		result = fmt.Sprintf("%s %s", e.Time.Format(time.RFC3339), result)
+	}
+
+	if e.Caller != "" {
+		result += fmt.Sprintf(" (%s)", e.Caller)
+	}
+
+	if len(e.Data) > 0 {
+		for k, v := range e.Data {
+			result += fmt.Sprintf(" [%v: %v]", k, v)
+		}
+	}
+
+	return []byte(result), nil
+}
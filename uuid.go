@@ -0,0 +1,20 @@
+package mute
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// newUUID returns a random RFC 4122 version 4 UUID.
+func newUUID() string {
+	var b [16]byte
+	// crypto/rand.Read on an in-memory buffer only fails if the system
+	// entropy source is unavailable, which would already be fatal to the
+	// process; a zero-value buffer is an acceptable degraded fallback.
+	_, _ = rand.Read(b[:])
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}